@@ -0,0 +1,84 @@
+package semver
+
+// IncrementMajor bumps the major version, resetting minor and patch to
+// zero and clearing any pre-release or build metadata.
+func (v *Version) IncrementMajor() {
+	v.Major++
+	v.Minor = 0
+	v.Patch = 0
+	v.Pre = nil
+	v.Build = nil
+}
+
+// IncrementMinor bumps the minor version, resetting patch to zero and
+// clearing any pre-release or build metadata.
+func (v *Version) IncrementMinor() {
+	v.Minor++
+	v.Patch = 0
+	v.Pre = nil
+	v.Build = nil
+}
+
+// IncrementPatch bumps the patch version and clears any pre-release or
+// build metadata.
+func (v *Version) IncrementPatch() {
+	v.Patch++
+	v.Pre = nil
+	v.Build = nil
+}
+
+// NextMajor returns a new version with the major version bumped, leaving v
+// unmodified.
+func (v *Version) NextMajor() *Version {
+	nv := *v
+	nv.IncrementMajor()
+	return &nv
+}
+
+// NextMinor returns a new version with the minor version bumped, leaving v
+// unmodified.
+func (v *Version) NextMinor() *Version {
+	nv := *v
+	nv.IncrementMinor()
+	return &nv
+}
+
+// NextPatch returns a new version with the patch version bumped, leaving v
+// unmodified.
+func (v *Version) NextPatch() *Version {
+	nv := *v
+	nv.IncrementPatch()
+	return &nv
+}
+
+// SetPreRelease parses s as a dot-separated pre-release identifier chain
+// and sets it on v. An empty string clears the pre-release.
+func (v *Version) SetPreRelease(s string) error {
+	if s == "" {
+		v.Pre = nil
+		return nil
+	}
+
+	pre, err := parsePRVersions(s)
+	if err != nil {
+		return err
+	}
+	v.Pre = pre
+	return nil
+}
+
+// SetMetadata parses s as a dot-separated build metadata identifier chain
+// and sets it on v. An empty string clears the build metadata.
+func (v *Version) SetMetadata(s string) error {
+	if s == "" {
+		v.Build = nil
+		return nil
+	}
+
+	build, err := parseBuildIdentifiers(s)
+	if err != nil {
+		return err
+	}
+	v.Build = build
+	return nil
+}
@@ -0,0 +1,105 @@
+package semver
+
+import "testing"
+
+func TestIncrementMajor(t *testing.T) {
+	v, _ := NewVersion("1.2.3-alpha+build.1")
+	v.IncrementMajor()
+
+	want := "2.0.0"
+	if got := v.String(); got != want {
+		t.Errorf("IncrementMajor() = %s, want %s", got, want)
+	}
+}
+
+func TestIncrementMinor(t *testing.T) {
+	v, _ := NewVersion("1.2.3-alpha+build.1")
+	v.IncrementMinor()
+
+	want := "1.3.0"
+	if got := v.String(); got != want {
+		t.Errorf("IncrementMinor() = %s, want %s", got, want)
+	}
+}
+
+func TestIncrementPatch(t *testing.T) {
+	v, _ := NewVersion("1.2.3-alpha+build.1")
+	v.IncrementPatch()
+
+	want := "1.2.4"
+	if got := v.String(); got != want {
+		t.Errorf("IncrementPatch() = %s, want %s", got, want)
+	}
+}
+
+func TestNextMajorMinorPatchAreImmutable(t *testing.T) {
+	v, _ := NewVersion("1.2.3-alpha+build.1")
+
+	next := v.NextMajor()
+	if got, want := next.String(), "2.0.0"; got != want {
+		t.Errorf("NextMajor() = %s, want %s", got, want)
+	}
+	if got, want := v.String(), "1.2.3-alpha+build.1"; got != want {
+		t.Errorf("NextMajor() mutated the receiver: got %s, want %s", got, want)
+	}
+
+	next = v.NextMinor()
+	if got, want := next.String(), "1.3.0"; got != want {
+		t.Errorf("NextMinor() = %s, want %s", got, want)
+	}
+	if got, want := v.String(), "1.2.3-alpha+build.1"; got != want {
+		t.Errorf("NextMinor() mutated the receiver: got %s, want %s", got, want)
+	}
+
+	next = v.NextPatch()
+	if got, want := next.String(), "1.2.4"; got != want {
+		t.Errorf("NextPatch() = %s, want %s", got, want)
+	}
+	if got, want := v.String(), "1.2.3-alpha+build.1"; got != want {
+		t.Errorf("NextPatch() mutated the receiver: got %s, want %s", got, want)
+	}
+}
+
+func TestSetPreRelease(t *testing.T) {
+	v, _ := NewVersion("1.2.3")
+
+	if err := v.SetPreRelease("beta.1"); err != nil {
+		t.Fatalf("SetPreRelease returned error: %v", err)
+	}
+	if got, want := v.String(), "1.2.3-beta.1"; got != want {
+		t.Errorf("SetPreRelease: got %s, want %s", got, want)
+	}
+
+	if err := v.SetPreRelease(""); err != nil {
+		t.Fatalf("SetPreRelease(\"\") returned error: %v", err)
+	}
+	if got, want := v.String(), "1.2.3"; got != want {
+		t.Errorf("SetPreRelease(\"\"): got %s, want %s", got, want)
+	}
+
+	if err := v.SetPreRelease("01"); err == nil {
+		t.Error("SetPreRelease(\"01\") expected error for leading zero, got nil")
+	}
+}
+
+func TestSetMetadata(t *testing.T) {
+	v, _ := NewVersion("1.2.3")
+
+	if err := v.SetMetadata("build.42"); err != nil {
+		t.Fatalf("SetMetadata returned error: %v", err)
+	}
+	if got, want := v.String(), "1.2.3+build.42"; got != want {
+		t.Errorf("SetMetadata: got %s, want %s", got, want)
+	}
+
+	if err := v.SetMetadata(""); err != nil {
+		t.Fatalf("SetMetadata(\"\") returned error: %v", err)
+	}
+	if got, want := v.String(), "1.2.3"; got != want {
+		t.Errorf("SetMetadata(\"\"): got %s, want %s", got, want)
+	}
+
+	if err := v.SetMetadata("bad_metadata!"); err == nil {
+		t.Error("SetMetadata(\"bad_metadata!\") expected error, got nil")
+	}
+}
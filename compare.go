@@ -0,0 +1,105 @@
+package semver
+
+import "sort"
+
+// Compare returns -1, 0, or 1 depending on whether v sorts before, equal
+// to, or after v2, following SemVer 2.0.0 precedence rules. Build metadata
+// is ignored, as it carries no precedence per the spec.
+func (v *Version) Compare(v2 *Version) int {
+	if v.Major != v2.Major {
+		return compareUint64(v.Major, v2.Major)
+	}
+	if v.Minor != v2.Minor {
+		return compareUint64(v.Minor, v2.Minor)
+	}
+	if v.Patch != v2.Patch {
+		return compareUint64(v.Patch, v2.Patch)
+	}
+
+	// A version without a pre-release outranks one with a pre-release.
+	if len(v.Pre) == 0 && len(v2.Pre) == 0 {
+		return 0
+	}
+	if len(v.Pre) == 0 {
+		return 1
+	}
+	if len(v2.Pre) == 0 {
+		return -1
+	}
+
+	for i := 0; i < len(v.Pre) && i < len(v2.Pre); i++ {
+		if c := v.Pre[i].compare(v2.Pre[i]); c != 0 {
+			return c
+		}
+	}
+
+	// All shared identifiers are equal; the longer chain wins.
+	return compareUint64(uint64(len(v.Pre)), uint64(len(v2.Pre)))
+}
+
+// compare orders two pre-release identifiers per the SemVer spec: numeric
+// identifiers compare numerically and always rank lower than alphanumeric
+// identifiers, which compare lexically.
+func (pr PRVersion) compare(pr2 PRVersion) int {
+	if pr.IsNum && pr2.IsNum {
+		return compareUint64(pr.VersionNum, pr2.VersionNum)
+	}
+	if pr.IsNum {
+		return -1
+	}
+	if pr2.IsNum {
+		return 1
+	}
+	if pr.VersionStr < pr2.VersionStr {
+		return -1
+	}
+	if pr.VersionStr > pr2.VersionStr {
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a, b uint64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Versions is a sortable list of versions, ordered by ascending SemVer
+// precedence.
+type Versions []*Version
+
+func (vs Versions) Len() int           { return len(vs) }
+func (vs Versions) Swap(i, j int)      { vs[i], vs[j] = vs[j], vs[i] }
+func (vs Versions) Less(i, j int) bool { return vs[i].Compare(vs[j]) < 0 }
+
+// Less reports whether v1 sorts before v2.
+func Less(v1, v2 *Version) bool {
+	return v1.Compare(v2) < 0
+}
+
+// Sort sorts a slice of versions in place, ordered by ascending SemVer
+// precedence.
+func Sort(vs []*Version) {
+	sort.Sort(Versions(vs))
+}
+
+// Latest returns the highest-precedence version in vs, or nil if vs is
+// empty.
+func Latest(vs []*Version) *Version {
+	if len(vs) == 0 {
+		return nil
+	}
+
+	latest := vs[0]
+	for _, v := range vs[1:] {
+		if v.Compare(latest) > 0 {
+			latest = v
+		}
+	}
+	return latest
+}
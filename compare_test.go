@@ -0,0 +1,95 @@
+package semver
+
+import "testing"
+
+func TestVersionCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.2.4", "1.2.3", 1},
+		{"1.2.3", "1.3.0", -1},
+		{"2.0.0", "1.9.9", 1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0", "1.0.0-alpha", 1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta", "1.0.0-beta.2", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0+build.1", "1.0.0+build.2", 0},
+	}
+
+	for _, tt := range tests {
+		va, err := NewVersion(tt.a)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) returned error: %v", tt.a, err)
+		}
+		vb, err := NewVersion(tt.b)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) returned error: %v", tt.b, err)
+		}
+
+		if got := va.Compare(vb); got != tt.want {
+			t.Errorf("%s.Compare(%s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLess(t *testing.T) {
+	v1, _ := NewVersion("1.0.0")
+	v2, _ := NewVersion("2.0.0")
+
+	if !Less(v1, v2) {
+		t.Errorf("expected Less(%s, %s) to be true", v1, v2)
+	}
+	if Less(v2, v1) {
+		t.Errorf("expected Less(%s, %s) to be false", v2, v1)
+	}
+}
+
+func TestSort(t *testing.T) {
+	raw := []string{"1.2.3", "1.0.0", "2.0.0-alpha", "1.2.3-beta", "2.0.0"}
+	vs := make([]*Version, len(raw))
+	for i, s := range raw {
+		v, err := NewVersion(s)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) returned error: %v", s, err)
+		}
+		vs[i] = v
+	}
+
+	Sort(vs)
+
+	want := []string{"1.0.0", "1.2.3-beta", "1.2.3", "2.0.0-alpha", "2.0.0"}
+	for i, w := range want {
+		if got := vs[i].String(); got != w {
+			t.Errorf("vs[%d] = %s, want %s", i, got, w)
+		}
+	}
+}
+
+func TestLatest(t *testing.T) {
+	if got := Latest(nil); got != nil {
+		t.Errorf("Latest(nil) = %v, want nil", got)
+	}
+
+	raw := []string{"1.2.3", "1.0.0", "2.0.0-alpha", "2.0.0", "1.9.9"}
+	vs := make([]*Version, len(raw))
+	for i, s := range raw {
+		v, err := NewVersion(s)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) returned error: %v", s, err)
+		}
+		vs[i] = v
+	}
+
+	latest := Latest(vs)
+	if latest == nil || latest.String() != "2.0.0" {
+		t.Errorf("Latest(%v) = %v, want 2.0.0", raw, latest)
+	}
+}
@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON implements json.Marshaler, encoding v as its canonical string
+// form.
+func (v *Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding v from its canonical
+// string form.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := NewVersion(s)
+	if err != nil {
+		return err
+	}
+
+	*v = *parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding v as its
+// canonical string form.
+func (v *Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding v from its
+// canonical string form.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := NewVersion(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = *parsed
+	return nil
+}
+
+// Value implements driver.Valuer, so a *Version can be written directly to
+// a VARCHAR/TEXT database column. A nil *Version stores as SQL NULL.
+func (v *Version) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements sql.Scanner, so a *Version can be read directly from a
+// VARCHAR/TEXT database column. It accepts string, []byte, and nil.
+func (v *Version) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case nil:
+		*v = Version{}
+		return nil
+	case string:
+		return v.scanString(s)
+	case []byte:
+		return v.scanString(string(s))
+	default:
+		return fmt.Errorf("semver: cannot scan %T into Version", src)
+	}
+}
+
+func (v *Version) scanString(s string) error {
+	parsed, err := NewVersion(s)
+	if err != nil {
+		return err
+	}
+
+	*v = *parsed
+	return nil
+}
@@ -0,0 +1,108 @@
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVersionJSONRoundTrip(t *testing.T) {
+	v, err := NewVersion("1.2.3-alpha.1+build.1")
+	if err != nil {
+		t.Fatalf("NewVersion returned error: %v", err)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if want := `"1.2.3-alpha.1+build.1"`; string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+
+	var got Version
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if !got.Equal(v) {
+		t.Errorf("round-tripped version %s, want %s", &got, v)
+	}
+}
+
+func TestVersionJSONUnmarshalInvalid(t *testing.T) {
+	var v Version
+	if err := json.Unmarshal([]byte(`"not-a-version"`), &v); err == nil {
+		t.Error("expected json.Unmarshal to return an error for an invalid version string")
+	}
+}
+
+func TestVersionTextRoundTrip(t *testing.T) {
+	v, err := NewVersion("1.2.3")
+	if err != nil {
+		t.Fatalf("NewVersion returned error: %v", err)
+	}
+
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText returned error: %v", err)
+	}
+
+	var got Version
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText returned error: %v", err)
+	}
+	if !got.Equal(v) {
+		t.Errorf("round-tripped version %s, want %s", &got, v)
+	}
+}
+
+func TestVersionValue(t *testing.T) {
+	v, _ := NewVersion("1.2.3")
+
+	val, err := v.Value()
+	if err != nil {
+		t.Fatalf("Value returned error: %v", err)
+	}
+	if val != "1.2.3" {
+		t.Errorf("Value() = %v, want %q", val, "1.2.3")
+	}
+
+	var nilVersion *Version
+	val, err = nilVersion.Value()
+	if err != nil {
+		t.Fatalf("Value on nil *Version returned error: %v", err)
+	}
+	if val != nil {
+		t.Errorf("Value() on nil *Version = %v, want nil", val)
+	}
+}
+
+func TestVersionScan(t *testing.T) {
+	var v Version
+	if err := v.Scan("1.2.3"); err != nil {
+		t.Fatalf("Scan(string) returned error: %v", err)
+	}
+	if got := v.String(); got != "1.2.3" {
+		t.Errorf("Scan(string): got %s, want 1.2.3", got)
+	}
+
+	var v2 Version
+	if err := v2.Scan([]byte("1.2.4")); err != nil {
+		t.Fatalf("Scan([]byte) returned error: %v", err)
+	}
+	if got := v2.String(); got != "1.2.4" {
+		t.Errorf("Scan([]byte): got %s, want 1.2.4", got)
+	}
+
+	v3 := Version{Major: 1, Minor: 2, Patch: 3}
+	if err := v3.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) returned error: %v", err)
+	}
+	if got := v3.String(); got != "0.0.0" {
+		t.Errorf("Scan(nil): got %s, want 0.0.0", got)
+	}
+
+	var v4 Version
+	if err := v4.Scan(42); err == nil {
+		t.Error("Scan(int) expected error, got nil")
+	}
+}
@@ -0,0 +1,94 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// DefaultVersionHeader is the header RequireMinVersion reads from when no
+// header name is supplied.
+const DefaultVersionHeader = "X-Api-Version"
+
+// ErrVersionNotSupplied is returned by MinimumRequired, and reported by
+// RequireMinVersion, when the caller did not supply a version at all.
+var ErrVersionNotSupplied = errors.New("semver: no version supplied")
+
+// VersionTooOldError reports that a supplied version did not meet a
+// minimum requirement.
+type VersionTooOldError struct {
+	Supplied *Version
+	Minimum  *Version
+}
+
+func (e *VersionTooOldError) Error() string {
+	return fmt.Sprintf("semver: version %s is older than the minimum required version %s", e.Supplied, e.Minimum)
+}
+
+// MinimumRequired returns a gate function that rejects any version older
+// than min. It returns ErrVersionNotSupplied for a nil version and a
+// *VersionTooOldError when the version is too old, so callers can tell the
+// two failure modes apart.
+func MinimumRequired(min *Version) func(*Version) error {
+	return func(v *Version) error {
+		if v == nil {
+			return ErrVersionNotSupplied
+		}
+		if v.Compare(min) < 0 {
+			return &VersionTooOldError{Supplied: v, Minimum: min}
+		}
+		return nil
+	}
+}
+
+// pathValuer is satisfied by *http.Request on Go versions that support
+// ServeMux path variables (PathValue, added in Go 1.22).
+type pathValuer interface {
+	PathValue(string) string
+}
+
+func requestPathVersion(r *http.Request) string {
+	if pv, ok := any(r).(pathValuer); ok {
+		return pv.PathValue("version")
+	}
+	return ""
+}
+
+// RequireMinVersion returns net/http middleware that rejects requests
+// whose client version is below min. The version is read from the named
+// header (DefaultVersionHeader if header is empty) or, failing that, from
+// a "{version}" path variable, and parsed with ParseTolerant so that
+// "v1.2.3"-style client versions are accepted. Requests missing a version
+// get a 400; requests below min get a 426 Upgrade Required.
+func RequireMinVersion(min *Version, header string) func(http.Handler) http.Handler {
+	if header == "" {
+		header = DefaultVersionHeader
+	}
+	gate := MinimumRequired(min)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			raw := r.Header.Get(header)
+			if raw == "" {
+				raw = requestPathVersion(r)
+			}
+			if raw == "" {
+				http.Error(w, ErrVersionNotSupplied.Error(), http.StatusBadRequest)
+				return
+			}
+
+			v, err := ParseTolerant(raw)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("semver: invalid version %q: %v", raw, err), http.StatusBadRequest)
+				return
+			}
+
+			if err := gate(v); err != nil {
+				http.Error(w, err.Error(), http.StatusUpgradeRequired)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package semver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMinimumRequired(t *testing.T) {
+	min, _ := NewVersion("1.2.0")
+	gate := MinimumRequired(min)
+
+	if err := gate(nil); err != ErrVersionNotSupplied {
+		t.Errorf("gate(nil) = %v, want ErrVersionNotSupplied", err)
+	}
+
+	old, _ := NewVersion("1.1.0")
+	err := gate(old)
+	if _, ok := err.(*VersionTooOldError); !ok {
+		t.Errorf("gate(%s) = %v (%T), want *VersionTooOldError", old, err, err)
+	}
+
+	ok, _ := NewVersion("1.2.0")
+	if err := gate(ok); err != nil {
+		t.Errorf("gate(%s) = %v, want nil", ok, err)
+	}
+
+	newer, _ := NewVersion("1.3.0")
+	if err := gate(newer); err != nil {
+		t.Errorf("gate(%s) = %v, want nil", newer, err)
+	}
+}
+
+func TestRequireMinVersionMissing(t *testing.T) {
+	min, _ := NewVersion("1.0.0")
+	mw := RequireMinVersion(min, "")
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestRequireMinVersionTooOld(t *testing.T) {
+	min, _ := NewVersion("2.0.0")
+	mw := RequireMinVersion(min, "")
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(DefaultVersionHeader, "v1.5.0")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUpgradeRequired {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUpgradeRequired)
+	}
+}
+
+func TestRequireMinVersionOK(t *testing.T) {
+	min, _ := NewVersion("1.0.0")
+	called := false
+	mw := RequireMinVersion(min, "")
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(DefaultVersionHeader, "v1.5.0")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !called {
+		t.Error("expected the wrapped handler to be called")
+	}
+}
+
+func TestRequireMinVersionCustomHeader(t *testing.T) {
+	min, _ := NewVersion("1.0.0")
+	mw := RequireMinVersion(min, "X-Client-Version")
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(DefaultVersionHeader, "v1.5.0")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	// The default header is ignored once a custom header name is configured,
+	// so the request should be treated as missing a version.
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Client-Version", "v1.5.0")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestRequireMinVersionInvalidVersion(t *testing.T) {
+	min, _ := NewVersion("1.0.0")
+	mw := RequireMinVersion(min, "")
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(DefaultVersionHeader, "not-a-version")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+// TestRequestPathVersion pins the fallback behavior of the PathValue
+// runtime-assertion trick: on Go toolchains where *http.Request does not
+// implement PathValue (added in Go 1.22), it must return "" rather than
+// panic.
+func TestRequestPathVersion(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	if got := requestPathVersion(req); got != "" {
+		t.Errorf("requestPathVersion on a plain *http.Request = %q, want empty", got)
+	}
+}
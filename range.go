@@ -0,0 +1,323 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Range reports whether a version satisfies a constraint expression.
+type Range func(*Version) bool
+
+// Satisfies reports whether v satisfies r.
+func (v *Version) Satisfies(r Range) bool {
+	return r(v)
+}
+
+// comparator is a single op+version test, e.g. ">=1.2.3".
+type comparator struct {
+	op  string
+	ver *Version
+}
+
+func (c comparator) satisfiedBy(v *Version) bool {
+	cmp := v.Compare(c.ver)
+	switch c.op {
+	case "=", "==":
+		return cmp == 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+// comparatorSet is a set of comparators that must all be satisfied (an AND
+// group), e.g. ">=1.2.0 <2.0.0".
+type comparatorSet []comparator
+
+func (cs comparatorSet) satisfiedBy(v *Version) bool {
+	if len(v.Pre) > 0 && !cs.allowsPreReleaseOf(v) {
+		return false
+	}
+	for _, c := range cs {
+		if !c.satisfiedBy(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsPreReleaseOf reports whether cs explicitly names a pre-release with
+// the same major.minor.patch as v, which is required for a pre-release
+// version to satisfy a comparator set (npm semantics).
+func (cs comparatorSet) allowsPreReleaseOf(v *Version) bool {
+	for _, c := range cs {
+		cv := c.ver
+		if len(cv.Pre) > 0 && cv.Major == v.Major && cv.Minor == v.Minor && cv.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+var rangeItemPattern = regexp.MustCompile(`^(?i)(\d+|x|\*)(?:\.(\d+|x|\*))?(?:\.(\d+|x|\*))?(?:-([0-9A-Za-z.-]+))?$`)
+
+var rangeOperators = []string{">=", "<=", "==", ">", "<", "="}
+
+// ParseRange parses a constraint expression such as ">=1.2.0 <2.0.0",
+// "~1.2.3", "^1.2.3", or "1.2.x", including "||"-separated alternatives,
+// and returns a Range that tests version membership.
+func ParseRange(expr string) (Range, error) {
+	orParts := strings.Split(expr, "||")
+	sets := make([]comparatorSet, 0, len(orParts))
+	for _, part := range orParts {
+		cs, err := parseComparatorSet(part)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, cs)
+	}
+
+	return func(v *Version) bool {
+		for _, cs := range sets {
+			if cs.satisfiedBy(v) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func parseComparatorSet(expr string) (comparatorSet, error) {
+	fields := strings.Fields(expr)
+	if len(fields) == 0 {
+		return nil, errors.New("Range expression is empty")
+	}
+
+	var cs comparatorSet
+	for _, field := range fields {
+		comparators, err := parseRangeItem(field)
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, comparators...)
+	}
+	return cs, nil
+}
+
+func parseRangeItem(item string) ([]comparator, error) {
+	if strings.HasPrefix(item, "~") {
+		return parseTildeRange(item[1:])
+	}
+	if strings.HasPrefix(item, "^") {
+		return parseCaretRange(item[1:])
+	}
+
+	for _, op := range rangeOperators {
+		if strings.HasPrefix(item, op) {
+			return parseOperatorRange(op, strings.TrimSpace(item[len(op):]))
+		}
+	}
+
+	return parseXRange(item)
+}
+
+func parseVersionSegments(s string) (majorS, minorS, patchS, preS string, err error) {
+	m := rangeItemPattern.FindStringSubmatch(s)
+	if m == nil {
+		return "", "", "", "", fmt.Errorf("Invalid range version %q", s)
+	}
+	return m[1], m[2], m[3], m[4], nil
+}
+
+func isWildcardSegment(s string) bool {
+	return s == "" || s == "*" || strings.EqualFold(s, "x")
+}
+
+func parseSegment(s string) (uint64, error) {
+	if isWildcardSegment(s) {
+		return 0, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid numeric component %q: %v", s, err)
+	}
+	return n, nil
+}
+
+// parseOperatorRange handles items with an explicit comparison operator,
+// e.g. ">=1.2", filling any missing or wildcard segments with zero.
+func parseOperatorRange(op, verStr string) ([]comparator, error) {
+	majorS, minorS, patchS, preS, err := parseVersionSegments(verStr)
+	if err != nil {
+		return nil, err
+	}
+
+	major, err := parseSegment(majorS)
+	if err != nil {
+		return nil, err
+	}
+	minor, err := parseSegment(minorS)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := parseSegment(patchS)
+	if err != nil {
+		return nil, err
+	}
+
+	ver := &Version{Major: major, Minor: minor, Patch: patch}
+	if preS != "" {
+		pre, err := parsePRVersions(preS)
+		if err != nil {
+			return nil, err
+		}
+		ver.Pre = pre
+	}
+
+	return []comparator{{op: op, ver: ver}}, nil
+}
+
+// parseXRange handles bare versions, including x-ranges like "1.2.x" or
+// partial versions like "1.2". A fully specified version is an exact match;
+// a wildcard or missing segment expands to a half-open range.
+func parseXRange(s string) ([]comparator, error) {
+	majorS, minorS, patchS, preS, err := parseVersionSegments(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if isWildcardSegment(majorS) {
+		return nil, nil
+	}
+	major, err := parseSegment(majorS)
+	if err != nil {
+		return nil, err
+	}
+
+	if isWildcardSegment(minorS) {
+		lower := &Version{Major: major}
+		upper := &Version{Major: major + 1}
+		return boundedRange(lower, upper), nil
+	}
+	minor, err := parseSegment(minorS)
+	if err != nil {
+		return nil, err
+	}
+
+	if isWildcardSegment(patchS) {
+		lower := &Version{Major: major, Minor: minor}
+		upper := &Version{Major: major, Minor: minor + 1}
+		return boundedRange(lower, upper), nil
+	}
+	patch, err := parseSegment(patchS)
+	if err != nil {
+		return nil, err
+	}
+
+	ver := &Version{Major: major, Minor: minor, Patch: patch}
+	if preS != "" {
+		pre, err := parsePRVersions(preS)
+		if err != nil {
+			return nil, err
+		}
+		ver.Pre = pre
+	}
+	return []comparator{{op: "=", ver: ver}}, nil
+}
+
+// parseTildeRange implements "~", which allows patch-level changes if a
+// minor version is specified, or minor-level changes if not.
+func parseTildeRange(s string) ([]comparator, error) {
+	majorS, minorS, patchS, preS, err := parseVersionSegments(s)
+	if err != nil {
+		return nil, err
+	}
+
+	major, err := parseSegment(majorS)
+	if err != nil {
+		return nil, err
+	}
+	minor, err := parseSegment(minorS)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := parseSegment(patchS)
+	if err != nil {
+		return nil, err
+	}
+
+	lower := &Version{Major: major, Minor: minor, Patch: patch}
+	if preS != "" {
+		pre, err := parsePRVersions(preS)
+		if err != nil {
+			return nil, err
+		}
+		lower.Pre = pre
+	}
+
+	var upper *Version
+	if isWildcardSegment(minorS) {
+		upper = &Version{Major: major + 1}
+	} else {
+		upper = &Version{Major: major, Minor: minor + 1}
+	}
+	return boundedRange(lower, upper), nil
+}
+
+// parseCaretRange implements "^", which allows changes that do not modify
+// the left-most non-zero digit of major.minor.patch.
+func parseCaretRange(s string) ([]comparator, error) {
+	majorS, minorS, patchS, preS, err := parseVersionSegments(s)
+	if err != nil {
+		return nil, err
+	}
+
+	major, err := parseSegment(majorS)
+	if err != nil {
+		return nil, err
+	}
+	minor, err := parseSegment(minorS)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := parseSegment(patchS)
+	if err != nil {
+		return nil, err
+	}
+
+	lower := &Version{Major: major, Minor: minor, Patch: patch}
+	if preS != "" {
+		pre, err := parsePRVersions(preS)
+		if err != nil {
+			return nil, err
+		}
+		lower.Pre = pre
+	}
+
+	var upper *Version
+	switch {
+	case major > 0 || (isWildcardSegment(minorS) && isWildcardSegment(patchS)):
+		upper = &Version{Major: major + 1}
+	case minor > 0 || isWildcardSegment(patchS):
+		upper = &Version{Major: major, Minor: minor + 1}
+	default:
+		upper = &Version{Major: major, Minor: minor, Patch: patch + 1}
+	}
+	return boundedRange(lower, upper), nil
+}
+
+func boundedRange(lower, upper *Version) []comparator {
+	return []comparator{
+		{op: ">=", ver: lower},
+		{op: "<", ver: upper},
+	}
+}
@@ -0,0 +1,121 @@
+package semver
+
+import "testing"
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		expr    string
+		matches []string
+		rejects []string
+	}{
+		{
+			expr:    "^1.2.3",
+			matches: []string{"1.2.3", "1.2.4", "1.3.0", "1.9.9"},
+			rejects: []string{"1.2.2", "2.0.0"},
+		},
+		{
+			expr:    "^0.2.3",
+			matches: []string{"0.2.3", "0.2.9"},
+			rejects: []string{"0.2.2", "0.3.0", "1.0.0"},
+		},
+		{
+			expr:    "^0.0.3",
+			matches: []string{"0.0.3"},
+			rejects: []string{"0.0.2", "0.0.4", "0.1.0"},
+		},
+		{
+			expr:    "^1.2.x",
+			matches: []string{"1.2.0", "1.9.9"},
+			rejects: []string{"1.1.9", "2.0.0"},
+		},
+		{
+			expr:    "~1.2.3",
+			matches: []string{"1.2.3", "1.2.9"},
+			rejects: []string{"1.2.2", "1.3.0"},
+		},
+		{
+			expr:    "~1.2",
+			matches: []string{"1.2.0", "1.2.9"},
+			rejects: []string{"1.1.9", "1.3.0"},
+		},
+		{
+			expr:    "~1",
+			matches: []string{"1.0.0", "1.9.9"},
+			rejects: []string{"0.9.9", "2.0.0"},
+		},
+		{
+			expr:    "1.2.x",
+			matches: []string{"1.2.0", "1.2.7"},
+			rejects: []string{"1.1.9", "1.3.0"},
+		},
+		{
+			expr:    ">=1.2.0 <2.0.0",
+			matches: []string{"1.2.0", "1.9.9"},
+			rejects: []string{"1.1.9", "2.0.0"},
+		},
+		{
+			expr:    ">=1.2.0 <2.0.0 || 3.x",
+			matches: []string{"1.5.0", "3.5.0"},
+			rejects: []string{"2.5.0", "4.0.0"},
+		},
+		{
+			expr:    "~1.2.3-beta.2",
+			matches: []string{"1.2.3-beta.2", "1.2.3-beta.3", "1.2.3"},
+			rejects: []string{"1.2.3-beta.1", "1.3.0"},
+		},
+		{
+			expr:    "^1.2.3-beta.4",
+			matches: []string{"1.2.3-beta.4", "1.2.3", "1.9.9"},
+			rejects: []string{"1.2.3-beta.3", "2.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		r, err := ParseRange(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseRange(%q) returned error: %v", tt.expr, err)
+		}
+
+		for _, s := range tt.matches {
+			v, err := NewVersion(s)
+			if err != nil {
+				t.Fatalf("NewVersion(%q) returned error: %v", s, err)
+			}
+			if !r(v) {
+				t.Errorf("ParseRange(%q) should match %q but did not", tt.expr, s)
+			}
+		}
+
+		for _, s := range tt.rejects {
+			v, err := NewVersion(s)
+			if err != nil {
+				t.Fatalf("NewVersion(%q) returned error: %v", s, err)
+			}
+			if r(v) {
+				t.Errorf("ParseRange(%q) should not match %q but did", tt.expr, s)
+			}
+		}
+	}
+}
+
+func TestVersionSatisfies(t *testing.T) {
+	r, err := ParseRange(">=1.0.0")
+	if err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+
+	v, err := NewVersion("1.0.0")
+	if err != nil {
+		t.Fatalf("NewVersion returned error: %v", err)
+	}
+
+	if !v.Satisfies(r) {
+		t.Errorf("expected %s to satisfy range", v)
+	}
+}
+
+func TestParseRangeOverflow(t *testing.T) {
+	if _, err := ParseRange(">=99999999999999999999.0.0"); err == nil {
+		t.Error("expected ParseRange to reject an overflowing numeric component, got nil error")
+	}
+}
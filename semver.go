@@ -10,16 +10,37 @@ import (
 )
 
 const (
-	numerals     string = "0123456789"
-	alphabet            = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	alphaNumeric        = alphabet + numerals
+	numerals        string = "0123456789"
+	alphabet               = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	alphaNumeric           = alphabet + numerals
+	identifierChars        = alphaNumeric + "-"
 )
 
+// PRVersion represents a single dot-separated identifier in a pre-release
+// chain. It holds either a numeric identifier (IsNum
+// true, value in VersionNum) or an alphanumeric identifier (value in
+// VersionStr), per the SemVer 2.0.0 spec.
+type PRVersion struct {
+	VersionStr string
+	VersionNum uint64
+	IsNum      bool
+}
+
+// String returns the identifier in its original textual form.
+func (pr PRVersion) String() string {
+	if pr.IsNum {
+		return strconv.FormatUint(pr.VersionNum, 10)
+	}
+	return pr.VersionStr
+}
+
 // Version struct represents a semantic version
 type Version struct {
 	Major uint64
 	Minor uint64
 	Patch uint64
+	Pre   []PRVersion
+	Build []string
 }
 
 func (v *Version) String() string {
@@ -30,6 +51,24 @@ func (v *Version) String() string {
 	b = append(b, '.')
 	b = strconv.AppendUint(b, v.Patch, 10)
 
+	if len(v.Pre) > 0 {
+		b = append(b, '-')
+		b = append(b, v.Pre[0].String()...)
+		for _, pr := range v.Pre[1:] {
+			b = append(b, '.')
+			b = append(b, pr.String()...)
+		}
+	}
+
+	if len(v.Build) > 0 {
+		b = append(b, '+')
+		b = append(b, v.Build[0]...)
+		for _, build := range v.Build[1:] {
+			b = append(b, '.')
+			b = append(b, build...)
+		}
+	}
+
 	return string(b)
 }
 
@@ -46,6 +85,16 @@ func (v *Version) Equal(v2 *Version) bool {
 		return false
 	}
 
+	// Build metadata is ignored for equality per the SemVer spec.
+	if len(v.Pre) != len(v2.Pre) {
+		return false
+	}
+	for i := range v.Pre {
+		if v.Pre[i] != v2.Pre[i] {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -113,6 +162,25 @@ func NewVersion(s string) (*Version, error) {
 		return nil, errors.New("Version string empty")
 	}
 
+	// Build metadata is introduced by the first '+' and runs to the end.
+	var buildStr string
+	var hasBuild bool
+	if i := strings.IndexByte(s, '+'); i != -1 {
+		buildStr = s[i+1:]
+		hasBuild = true
+		s = s[:i]
+	}
+
+	// Pre-release is introduced by the first '-' remaining after build
+	// metadata has been stripped off.
+	var preStr string
+	var hasPre bool
+	if i := strings.IndexByte(s, '-'); i != -1 {
+		preStr = s[i+1:]
+		hasPre = true
+		s = s[:i]
+	}
+
 	// Split into major.minor.patch
 	parts := strings.SplitN(s, ".", 3)
 	if len(parts) != 3 {
@@ -160,9 +228,163 @@ func NewVersion(s string) (*Version, error) {
 	v.Minor = minor
 	v.Patch = patch
 
+	if hasPre {
+		pre, err := parsePRVersions(preStr)
+		if err != nil {
+			return nil, err
+		}
+		v.Pre = pre
+	}
+
+	if hasBuild {
+		build, err := parseBuildIdentifiers(buildStr)
+		if err != nil {
+			return nil, err
+		}
+		v.Build = build
+	}
+
 	return v, nil
 }
 
+// ParseTolerant parses a version string the way real-world tags are
+// written in the wild: it accepts a leading "v"/"V" or arbitrary alphabetic
+// prefix (e.g. "linkerd-2.9.1", "release-1.2"), trims surrounding
+// whitespace, strips leading zeroes from numeric components, and fills in
+// a missing minor or patch segment with zero ("1.2" becomes "1.2.0", "1"
+// becomes "1.0.0"). Unlike NewVersion, it is lenient about all of the
+// above; everything else (pre-release and build metadata syntax) is
+// validated the same way.
+func ParseTolerant(s string) (*Version, error) {
+	s = strings.TrimSpace(s)
+
+	i := strings.IndexFunc(s, func(r rune) bool { return r >= '0' && r <= '9' })
+	if i == -1 {
+		return nil, fmt.Errorf("Version string contains no numeric version %q", s)
+	}
+	s = s[i:]
+
+	var buildStr string
+	var hasBuild bool
+	if i := strings.IndexByte(s, '+'); i != -1 {
+		buildStr = s[i+1:]
+		hasBuild = true
+		s = s[:i]
+	}
+
+	var preStr string
+	var hasPre bool
+	if i := strings.IndexByte(s, '-'); i != -1 {
+		preStr = s[i+1:]
+		hasPre = true
+		s = s[:i]
+	}
+
+	parts := strings.SplitN(s, ".", 3)
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+
+	major, err := parseTolerantNumber(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	minor, err := parseTolerantNumber(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	patch, err := parseTolerantNumber(parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Version{Major: major, Minor: minor, Patch: patch}
+
+	if hasPre {
+		pre, err := parsePRVersions(preStr)
+		if err != nil {
+			return nil, err
+		}
+		v.Pre = pre
+	}
+
+	if hasBuild {
+		build, err := parseBuildIdentifiers(buildStr)
+		if err != nil {
+			return nil, err
+		}
+		v.Build = build
+	}
+
+	return v, nil
+}
+
+func parseTolerantNumber(s string) (uint64, error) {
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		s = "0"
+	}
+	if !containsOnly(s, numerals) {
+		return 0, fmt.Errorf("Invalid character(s) found in numeric component %q", s)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// parsePRVersions splits a pre-release string on '.' and validates each
+// dot-separated identifier, rejecting empty identifiers and numeric
+// identifiers with leading zeroes.
+func parsePRVersions(s string) ([]PRVersion, error) {
+	parts := strings.Split(s, ".")
+	pre := make([]PRVersion, len(parts))
+	for i, part := range parts {
+		pr, err := newPRVersion(part)
+		if err != nil {
+			return nil, err
+		}
+		pre[i] = pr
+	}
+	return pre, nil
+}
+
+func newPRVersion(s string) (PRVersion, error) {
+	if len(s) == 0 {
+		return PRVersion{}, errors.New("Pre-release identifier is empty")
+	}
+	if !containsOnly(s, identifierChars) {
+		return PRVersion{}, fmt.Errorf("Invalid character(s) found in pre-release identifier %q", s)
+	}
+
+	if containsOnly(s, numerals) {
+		if hasLeadingZeroes(s) {
+			return PRVersion{}, fmt.Errorf("Numeric pre-release identifier must not contain leading zeroes %q", s)
+		}
+		num, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return PRVersion{}, err
+		}
+		return PRVersion{VersionNum: num, IsNum: true}, nil
+	}
+
+	return PRVersion{VersionStr: s}, nil
+}
+
+// parseBuildIdentifiers splits a build metadata string on '.' and validates
+// each identifier. Unlike pre-release identifiers, purely numeric build
+// identifiers may contain leading zeroes since they carry no ordering
+// semantics.
+func parseBuildIdentifiers(s string) ([]string, error) {
+	parts := strings.Split(s, ".")
+	for _, part := range parts {
+		if len(part) == 0 {
+			return nil, errors.New("Build identifier is empty")
+		}
+		if !containsOnly(part, identifierChars) {
+			return nil, fmt.Errorf("Invalid character(s) found in build identifier %q", part)
+		}
+	}
+	return parts, nil
+}
+
 func containsOnly(s string, compare string) bool {
 	return strings.IndexFunc(s, func(r rune) bool {
 		return !strings.ContainsRune(compare, r)
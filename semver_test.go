@@ -0,0 +1,128 @@
+package semver
+
+import "testing"
+
+func TestNewVersionValid(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"0.0.0", "0.0.0"},
+		{"1.2.3", "1.2.3"},
+		{"1.2.3-alpha", "1.2.3-alpha"},
+		{"1.2.3-alpha.1", "1.2.3-alpha.1"},
+		{"1.2.3-0.3.7", "1.2.3-0.3.7"},
+		{"1.2.3+build.1", "1.2.3+build.1"},
+		{"1.2.3-alpha.1+build.42", "1.2.3-alpha.1+build.42"},
+		{"1.2.3+0001", "1.2.3+0001"},
+	}
+
+	for _, tt := range tests {
+		v, err := NewVersion(tt.in)
+		if err != nil {
+			t.Errorf("NewVersion(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got := v.String(); got != tt.want {
+			t.Errorf("NewVersion(%q).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNewVersionInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"1",
+		"1.2",
+		"1.2.3.4",
+		"01.2.3",
+		"1.02.3",
+		"1.2.03",
+		"a.b.c",
+		"1.2.3-",
+		"1.2.3-01",
+		"1.2.3-alpha_beta",
+		"1.2.3-.alpha",
+		"1.2.3+",
+		"1.2.3+build_meta!",
+	}
+
+	for _, in := range tests {
+		if _, err := NewVersion(in); err == nil {
+			t.Errorf("NewVersion(%q) expected error, got nil", in)
+		}
+	}
+}
+
+func TestVersionEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3+build.1", "1.2.3+build.2", true},
+		{"1.2.3-alpha", "1.2.3-alpha", true},
+		{"1.2.3-alpha+build.1", "1.2.3-alpha+build.2", true},
+		{"1.2.3-alpha", "1.2.3-beta", false},
+		{"1.2.3-alpha.1", "1.2.3-alpha", false},
+		{"1.2.3", "1.2.4", false},
+		{"1.2.3", "1.2.3-alpha", false},
+	}
+
+	for _, tt := range tests {
+		va, err := NewVersion(tt.a)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) returned error: %v", tt.a, err)
+		}
+		vb, err := NewVersion(tt.b)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) returned error: %v", tt.b, err)
+		}
+
+		if got := va.Equal(vb); got != tt.want {
+			t.Errorf("%s.Equal(%s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestParseTolerant(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"v1.2.3", "1.2.3"},
+		{"V1.2.3", "1.2.3"},
+		{"  1.2.3  ", "1.2.3"},
+		{"linkerd-2.9.1", "2.9.1"},
+		{"release-1.2", "1.2.0"},
+		{"1.2", "1.2.0"},
+		{"1", "1.0.0"},
+		{"01.02.03", "1.2.3"},
+		{"v1.2.3-alpha.1+build.1", "1.2.3-alpha.1+build.1"},
+	}
+
+	for _, tt := range tests {
+		v, err := ParseTolerant(tt.in)
+		if err != nil {
+			t.Errorf("ParseTolerant(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got := v.String(); got != tt.want {
+			t.Errorf("ParseTolerant(%q).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseTolerantInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"no-digits-here",
+		"1.2.3-alpha_beta",
+	}
+
+	for _, in := range tests {
+		if _, err := ParseTolerant(in); err == nil {
+			t.Errorf("ParseTolerant(%q) expected error, got nil", in)
+		}
+	}
+}